@@ -1,139 +1,101 @@
 package main
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
+	"flag"
 	"fmt"
-	"io/ioutil"
-	"net/http"
 	"os"
+	"os/signal"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/k13w/aiko/pkg/simulator"
 )
 
-// Configurações
-var (
-	modelName   = "ft:gpt-4.1-mini-2025-04-14:aiko:webhooks-mini:C9i3m2e7"
-	topicArn    = "arn:aws:sns:us-east-1:000000000000:CB-SPI_IUGU-MANUAL-HOOK-PIX-PAID_SENT"
-	numWebhooks = 1
-	apiKey      = os.Getenv("OPENAI_API_KEY")
-)
-
-// Estrutura para chamadas da API OpenAI
-type ChatMessage struct {
-	Role    string `json:"role"`
-	Content string `json:"content"`
-}
-
-type ChatRequest struct {
-	Model    string        `json:"model"`
-	Messages []ChatMessage `json:"messages"`
-}
-
-type Choice struct {
-	Message ChatMessage `json:"message"`
-}
-
-type ChatResponse struct {
-	Choices []Choice `json:"choices"`
+var defaultPrompts = []string{
+	"Simule um webhook de Pix Cash In de R$ 120",
+	"Simule um webhook de Pix Cash Out de R$ 250",
+	"Simule um webhook de Pix Cash In de R$ 500 pendente",
+	"Simule um webhook de Pix Cash Out de R$ 300",
+	"Simule um webhook de Pix Cash In de R$ 75",
 }
 
-func generateWebhook(prompt string) (string, error) {
-	url := "https://api.openai.com/v1/chat/completions"
-
-	reqBody := ChatRequest{
-		Model: modelName,
-		Messages: []ChatMessage{
-			{Role: "system", Content: "Você é um simulador de webhooks de Pix. Gere os eventos com IDs e valores fictícios."},
-			{Role: "user", Content: prompt},
-		},
-	}
-
-	jsonBody, err := json.Marshal(reqBody)
-	if err != nil {
-		return "", err
-	}
-
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonBody))
-	if err != nil {
-		return "", err
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+apiKey)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
+func main() {
+	configPath := flag.String("config", "config.yaml", "caminho para o arquivo de configuração (YAML ou JSON)")
+	concurrency := flag.Int("concurrency", 0, "número de webhooks gerados/publicados em paralelo (0 = usar o valor da config ou 1)")
+	recordPath := flag.String("record", "", "grava cada webhook gerado (prompt, resposta, resultado por sink) neste arquivo HAR-like")
+	replayPath := flag.String("replay", "", "reproduz webhooks gravados deste arquivo nos sinks, sem chamar o modelo")
+	replayFilter := flag.String("replay-filter", "", "ao reproduzir, restringe às entradas cujo prompt contém este template")
+	signingScheme := flag.String("signing-scheme", "", "assina sinks HTTP sem esquema próprio com este preset: iugu, stripe ou generic-hmac (segredo lido de WEBHOOK_HMAC_SECRET)")
+	flag.Parse()
+
+	cfg, err := simulator.LoadConfig(*configPath)
 	if err != nil {
-		return "", err
+		fmt.Println("Erro ao carregar config:", err)
+		os.Exit(1)
 	}
-	defer resp.Body.Close()
-
-	bodyBytes, _ := ioutil.ReadAll(resp.Body)
 
-	var chatResp ChatResponse
-	if err := json.Unmarshal(bodyBytes, &chatResp); err != nil {
-		return "", err
+	if *concurrency > 0 {
+		cfg.Concurrency = *concurrency
 	}
 
-	if len(chatResp.Choices) == 0 {
-		return "", fmt.Errorf("nenhuma resposta recebida do modelo")
+	if *signingScheme != "" {
+		for i := range cfg.Sinks {
+			if cfg.Sinks[i].Type != "http" || cfg.Sinks[i].SigningScheme != "" {
+				continue
+			}
+			cfg.Sinks[i].SigningScheme = *signingScheme
+			if cfg.Sinks[i].SigningSecretEnv == "" {
+				cfg.Sinks[i].SigningSecretEnv = "WEBHOOK_HMAC_SECRET"
+			}
+		}
 	}
 
-	return chatResp.Choices[0].Message.Content, nil
-}
-
-func publishWebhookToSNS(webhookText string) error {
-	// Criar sessão AWS
-	sess, err := session.NewSession(&aws.Config{
-		Region: aws.String("us-east-1"),
-	})
+	sinks, err := simulator.BuildSinks(cfg.Sinks)
 	if err != nil {
-		return fmt.Errorf("erro ao criar sessão AWS: %v", err)
+		fmt.Println("Erro ao construir sinks:", err)
+		os.Exit(1)
 	}
 
-	// Criar cliente SNS
-	snsClient := sns.New(sess)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
 
-	// Publicar mensagem no tópico
-	input := &sns.PublishInput{
-		TopicArn: aws.String(topicArn),
-		Message:  aws.String(webhookText),
-		Subject:  aws.String("Webhook PIX Simulado"),
+	runOpts := simulator.RunOptions{
+		Concurrency:   cfg.Concurrency,
+		RatePerSecond: cfg.RatePerSecond,
+		RetryAttempts: cfg.RetryAttempts,
 	}
 
-	result, err := snsClient.Publish(input)
-	if err != nil {
-		return fmt.Errorf("erro ao publicar no SNS: %v", err)
+	if *replayPath != "" {
+		report, err := simulator.Replay(ctx, *replayPath, *replayFilter, sinks, runOpts)
+		if err != nil {
+			fmt.Println("Erro ao reproduzir gravação:", err)
+			os.Exit(1)
+		}
+		fmt.Println(report)
+		return
 	}
 
-	fmt.Printf("Webhook publicado no SNS! MessageId: %s\n", *result.MessageId)
-	return nil
-}
-
-func main() {
-	prompts := []string{
-		"Simule um webhook de Pix Cash In de R$ 120",
-		"Simule um webhook de Pix Cash Out de R$ 250",
-		"Simule um webhook de Pix Cash In de R$ 500 pendente",
-		"Simule um webhook de Pix Cash Out de R$ 300",
-		"Simule um webhook de Pix Cash In de R$ 75",
+	gen, err := simulator.BuildGenerator(cfg.Generator, os.Getenv("OPENAI_API_KEY"))
+	if err != nil {
+		fmt.Println("Erro ao construir generator:", err)
+		os.Exit(1)
 	}
 
-	for i := 0; i < numWebhooks; i++ {
-		prompt := prompts[i%len(prompts)]
-		webhookText, err := generateWebhook(prompt)
-		if err != nil {
-			fmt.Println("Erro ao gerar webhook:", err)
-			continue
+	var recorder *simulator.Recorder
+	if *recordPath != "" {
+		recorder = simulator.NewRecorder(*recordPath)
+		runOpts.Recorder = recorder
+		runOpts.RecordHeaders = map[string]string{
+			"Authorization": "Bearer " + os.Getenv("OPENAI_API_KEY"),
 		}
+	}
 
-		fmt.Printf("\nWebhook gerado:\n%s\n\n", webhookText)
+	report := simulator.RunConcurrent(ctx, gen, sinks, defaultPrompts, cfg.NumWebhooks, runOpts)
+	fmt.Println(report)
 
-		if err := publishWebhookToSNS(webhookText); err != nil {
-			fmt.Println("Erro ao publicar webhook no SNS:", err)
+	if recorder != nil {
+		if err := recorder.Flush(); err != nil {
+			fmt.Println("Erro ao gravar:", err)
+			os.Exit(1)
 		}
 	}
 }
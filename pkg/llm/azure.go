@@ -0,0 +1,52 @@
+package llm
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// AzureConfig configura um cliente para a Azure OpenAI, que usa autenticação via
+// header `api-key` e uma URL baseada em deployment em vez de modelo.
+type AzureConfig struct {
+	APIKey       string
+	ResourceName string // ex.: "meu-recurso" em https://meu-recurso.openai.azure.com
+	Deployment   string
+	APIVersion   string // ex.: "2024-06-01"
+}
+
+// AzureClient fala com um deployment da Azure OpenAI.
+type AzureClient struct {
+	wire *openAIWireClient
+}
+
+// NewAzureClient cria um Client para um deployment da Azure OpenAI.
+func NewAzureClient(cfg AzureConfig) *AzureClient {
+	apiVersion := cfg.APIVersion
+	if apiVersion == "" {
+		apiVersion = "2024-06-01"
+	}
+
+	endpoint := fmt.Sprintf(
+		"https://%s.openai.azure.com/openai/deployments/%s/chat/completions?api-version=%s",
+		cfg.ResourceName, cfg.Deployment, apiVersion,
+	)
+
+	return &AzureClient{
+		wire: &openAIWireClient{
+			endpoint:   endpoint,
+			httpClient: &http.Client{},
+			setHeaders: func(r *http.Request) {
+				r.Header.Set("api-key", cfg.APIKey)
+			},
+		},
+	}
+}
+
+func (c *AzureClient) Chat(req ChatRequest) (string, error) {
+	// O modelo já está implícito no deployment da URL.
+	return c.wire.chat("", req)
+}
+
+func (c *AzureClient) ChatStream(req ChatRequest, onChunk func(string) bool) (string, error) {
+	return c.wire.chatStream("", req, onChunk)
+}
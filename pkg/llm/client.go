@@ -0,0 +1,36 @@
+// Package llm abstrai sobre diferentes backends de chat completion (OpenAI, Azure
+// OpenAI, Ollama e qualquer servidor compatível com a API da OpenAI), para que o
+// simulador não fique amarrado a um único provedor.
+package llm
+
+import "encoding/json"
+
+// Message é uma mensagem de chat no formato comum a todos os backends suportados.
+type Message struct {
+	Role    string
+	Content string
+}
+
+// ChatRequest descreve uma chamada de chat completion, independente do backend.
+type ChatRequest struct {
+	Messages    []Message
+	Temperature float64
+	TopP        float64
+	MaxTokens   int
+
+	// ResponseSchema, se preenchido, pede uma resposta em JSON estrito seguindo
+	// esse JSON Schema (quando o backend suportar; ignorado caso contrário).
+	ResponseSchema json.RawMessage
+	SchemaName     string
+}
+
+// Client é implementado por cada backend de LLM suportado.
+type Client interface {
+	// Chat envia req e retorna a resposta completa.
+	Chat(req ChatRequest) (string, error)
+	// ChatStream envia req em modo streaming, chamando onChunk a cada trecho
+	// acumulado recebido. Se onChunk retornar true, a leitura do stream é
+	// interrompida imediatamente e ChatStream retorna o trecho acumulado até
+	// ali; caso contrário, ChatStream só retorna ao final do stream.
+	ChatStream(req ChatRequest, onChunk func(partial string) (stop bool)) (string, error)
+}
@@ -0,0 +1,44 @@
+package llm
+
+import "fmt"
+
+// Config seleciona e parametriza qual backend de LLM usar. Os campos específicos
+// de cada backend são ignorados pelos demais.
+type Config struct {
+	Type string `json:"type" yaml:"type"` // "openai", "azure" ou "ollama"
+
+	APIKey   string `json:"apiKey" yaml:"apiKey"`
+	Model    string `json:"model" yaml:"model"`
+	Endpoint string `json:"endpoint" yaml:"endpoint"` // openai: URL override; ollama: base URL
+
+	// azure
+	ResourceName string `json:"resourceName" yaml:"resourceName"`
+	Deployment   string `json:"deployment" yaml:"deployment"`
+	APIVersion   string `json:"apiVersion" yaml:"apiVersion"`
+}
+
+// NewClient constrói o Client descrito por Config.
+func NewClient(cfg Config) (Client, error) {
+	switch cfg.Type {
+	case "", "openai":
+		return NewOpenAIClient(OpenAIConfig{
+			APIKey:   cfg.APIKey,
+			Model:    cfg.Model,
+			Endpoint: cfg.Endpoint,
+		}), nil
+	case "azure":
+		return NewAzureClient(AzureConfig{
+			APIKey:       cfg.APIKey,
+			ResourceName: cfg.ResourceName,
+			Deployment:   cfg.Deployment,
+			APIVersion:   cfg.APIVersion,
+		}), nil
+	case "ollama":
+		return NewOllamaClient(OllamaConfig{
+			BaseURL: cfg.Endpoint,
+			Model:   cfg.Model,
+		}), nil
+	default:
+		return nil, fmt.Errorf("tipo de backend de LLM desconhecido: %s", cfg.Type)
+	}
+}
@@ -0,0 +1,154 @@
+package llm
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// OllamaConfig configura um cliente para um endpoint compatível com a API `/api/chat`
+// do Ollama, usado para rodar o simulador offline/CI contra um modelo local.
+type OllamaConfig struct {
+	BaseURL string // ex.: "http://localhost:11434"
+	Model   string
+}
+
+// OllamaClient fala com o endpoint `/api/chat` do Ollama.
+type OllamaClient struct {
+	endpoint   string
+	model      string
+	httpClient *http.Client
+}
+
+// NewOllamaClient cria um Client para um endpoint Ollama-compatível.
+func NewOllamaClient(cfg OllamaConfig) *OllamaClient {
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = "http://localhost:11434"
+	}
+
+	return &OllamaClient{
+		endpoint:   baseURL + "/api/chat",
+		model:      cfg.Model,
+		httpClient: &http.Client{},
+	}
+}
+
+type ollamaMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type ollamaOptions struct {
+	Temperature float64 `json:"temperature,omitempty"`
+	TopP        float64 `json:"top_p,omitempty"`
+	NumPredict  int     `json:"num_predict,omitempty"`
+}
+
+type ollamaRequest struct {
+	Model    string          `json:"model"`
+	Messages []ollamaMessage `json:"messages"`
+	Stream   bool            `json:"stream"`
+	Options  ollamaOptions   `json:"options,omitempty"`
+	Format   json.RawMessage `json:"format,omitempty"`
+}
+
+type ollamaResponseLine struct {
+	Message ollamaMessage `json:"message"`
+	Done    bool          `json:"done"`
+}
+
+func (c *OllamaClient) buildRequest(req ChatRequest, stream bool) ollamaRequest {
+	messages := make([]ollamaMessage, 0, len(req.Messages))
+	for _, m := range req.Messages {
+		messages = append(messages, ollamaMessage{Role: m.Role, Content: m.Content})
+	}
+
+	return ollamaRequest{
+		Model:    c.model,
+		Messages: messages,
+		Stream:   stream,
+		Options: ollamaOptions{
+			Temperature: req.Temperature,
+			TopP:        req.TopP,
+			NumPredict:  req.MaxTokens,
+		},
+		Format: req.ResponseSchema,
+	}
+}
+
+func (c *OllamaClient) Chat(req ChatRequest) (string, error) {
+	body := c.buildRequest(req, false)
+
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.httpClient.Post(c.endpoint, "application/json", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var line ollamaResponseLine
+	if err := json.NewDecoder(resp.Body).Decode(&line); err != nil {
+		return "", err
+	}
+
+	if line.Message.Content == "" {
+		return "", fmt.Errorf("nenhuma resposta recebida do modelo")
+	}
+
+	return line.Message.Content, nil
+}
+
+// ChatStream lê as linhas NDJSON retornadas pelo Ollama quando stream=true,
+// concatenando o conteúdo de cada linha até receber done=true. Se onChunk
+// retornar true, a leitura para imediatamente sem esperar done=true.
+func (c *OllamaClient) ChatStream(req ChatRequest, onChunk func(string) bool) (string, error) {
+	body := c.buildRequest(req, true)
+
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.httpClient.Post(c.endpoint, "application/json", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var full []byte
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		var line ollamaResponseLine
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			continue
+		}
+
+		if line.Message.Content != "" {
+			full = append(full, line.Message.Content...)
+			if onChunk != nil && onChunk(string(full)) {
+				break
+			}
+		}
+
+		if line.Done {
+			break
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	if len(full) == 0 {
+		return "", fmt.Errorf("nenhuma resposta recebida do modelo")
+	}
+
+	return string(full), nil
+}
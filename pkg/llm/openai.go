@@ -0,0 +1,46 @@
+package llm
+
+import "net/http"
+
+// OpenAIConfig configura um cliente para a API de chat completions da OpenAI, ou
+// para qualquer servidor compatível com ela (basta sobrescrever Endpoint).
+type OpenAIConfig struct {
+	APIKey string
+	Model  string
+	// Endpoint, se vazio, usa a API pública da OpenAI. Sobrescreva para apontar
+	// para um servidor OpenAI-compatível (ex.: um proxy ou um gateway interno).
+	Endpoint string
+}
+
+// OpenAIClient fala com a API de chat completions da OpenAI (ou compatível).
+type OpenAIClient struct {
+	model string
+	wire  *openAIWireClient
+}
+
+// NewOpenAIClient cria um Client para a OpenAI ou para um servidor OpenAI-compatível.
+func NewOpenAIClient(cfg OpenAIConfig) *OpenAIClient {
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = "https://api.openai.com/v1/chat/completions"
+	}
+
+	return &OpenAIClient{
+		model: cfg.Model,
+		wire: &openAIWireClient{
+			endpoint:   endpoint,
+			httpClient: &http.Client{},
+			setHeaders: func(r *http.Request) {
+				r.Header.Set("Authorization", "Bearer "+cfg.APIKey)
+			},
+		},
+	}
+}
+
+func (c *OpenAIClient) Chat(req ChatRequest) (string, error) {
+	return c.wire.chat(c.model, req)
+}
+
+func (c *OpenAIClient) ChatStream(req ChatRequest, onChunk func(string) bool) (string, error) {
+	return c.wire.chatStream(c.model, req, onChunk)
+}
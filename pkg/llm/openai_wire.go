@@ -0,0 +1,199 @@
+package llm
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// openAIWireClient implementa a troca de mensagens HTTP comum à OpenAI, à Azure
+// OpenAI e a qualquer servidor compatível com a API de chat completions da OpenAI.
+// setHeaders permite que cada backend injete sua própria forma de autenticação.
+type openAIWireClient struct {
+	endpoint   string
+	httpClient *http.Client
+	setHeaders func(*http.Request)
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatRequestBody struct {
+	Messages       []chatMessage   `json:"messages"`
+	Temperature    float64         `json:"temperature,omitempty"`
+	TopP           float64         `json:"top_p,omitempty"`
+	MaxTokens      int             `json:"max_tokens,omitempty"`
+	ResponseFormat *responseFormat `json:"response_format,omitempty"`
+	Stream         bool            `json:"stream,omitempty"`
+
+	// Model é omitido por padrão: a Azure OpenAI codifica o modelo/deployment na
+	// URL, então só os clientes que precisam dele (OpenAI e servidores compatíveis) o preenchem.
+	Model string `json:"model,omitempty"`
+}
+
+type responseFormat struct {
+	Type       string         `json:"type"`
+	JSONSchema jsonSchemaSpec `json:"json_schema"`
+}
+
+type jsonSchemaSpec struct {
+	Name   string          `json:"name"`
+	Schema json.RawMessage `json:"schema"`
+	Strict bool            `json:"strict"`
+}
+
+type chatChoice struct {
+	Message chatMessage `json:"message"`
+}
+
+type chatResponseBody struct {
+	Choices []chatChoice `json:"choices"`
+}
+
+type streamChunk struct {
+	Choices []struct {
+		Delta struct {
+			Content string `json:"content"`
+		} `json:"delta"`
+	} `json:"choices"`
+}
+
+func buildRequestBody(model string, req ChatRequest, stream bool) chatRequestBody {
+	messages := make([]chatMessage, 0, len(req.Messages))
+	for _, m := range req.Messages {
+		messages = append(messages, chatMessage{Role: m.Role, Content: m.Content})
+	}
+
+	body := chatRequestBody{
+		Model:       model,
+		Messages:    messages,
+		Temperature: req.Temperature,
+		TopP:        req.TopP,
+		MaxTokens:   req.MaxTokens,
+		Stream:      stream,
+	}
+
+	if len(req.ResponseSchema) > 0 {
+		name := req.SchemaName
+		if name == "" {
+			name = "response"
+		}
+		body.ResponseFormat = &responseFormat{
+			Type: "json_schema",
+			JSONSchema: jsonSchemaSpec{
+				Name:   name,
+				Schema: req.ResponseSchema,
+				Strict: true,
+			},
+		}
+	}
+
+	return body
+}
+
+func (c *openAIWireClient) chat(model string, req ChatRequest) (string, error) {
+	body := buildRequestBody(model, req, false)
+
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+
+	httpReq, err := http.NewRequest("POST", c.endpoint, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return "", err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	c.setHeaders(httpReq)
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, _ := ioutil.ReadAll(resp.Body)
+
+	var chatResp chatResponseBody
+	if err := json.Unmarshal(bodyBytes, &chatResp); err != nil {
+		return "", err
+	}
+
+	if len(chatResp.Choices) == 0 {
+		return "", fmt.Errorf("nenhuma resposta recebida do modelo")
+	}
+
+	return chatResp.Choices[0].Message.Content, nil
+}
+
+func (c *openAIWireClient) chatStream(model string, req ChatRequest, onChunk func(string) bool) (string, error) {
+	body := buildRequestBody(model, req, true)
+
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+
+	httpReq, err := http.NewRequest("POST", c.endpoint, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return "", err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "text/event-stream")
+	c.setHeaders(httpReq)
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var full strings.Builder
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "data:") {
+			continue
+		}
+
+		data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		if data == "[DONE]" {
+			break
+		}
+
+		var chunk streamChunk
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			continue
+		}
+
+		stop := false
+		for _, choice := range chunk.Choices {
+			if choice.Delta.Content == "" {
+				continue
+			}
+			full.WriteString(choice.Delta.Content)
+			if onChunk != nil && onChunk(full.String()) {
+				stop = true
+			}
+		}
+		if stop {
+			break
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	if full.Len() == 0 {
+		return "", fmt.Errorf("nenhuma resposta recebida do modelo")
+	}
+
+	return full.String(), nil
+}
@@ -0,0 +1,38 @@
+package pix
+
+import "time"
+
+// EventType identifica a categoria do evento de webhook Pix.
+type EventType string
+
+const (
+	EventTypeCashIn  EventType = "cash_in"
+	EventTypeCashOut EventType = "cash_out"
+)
+
+// Status representa o estado da transação no momento do webhook.
+type Status string
+
+const (
+	StatusCompleted Status = "completed"
+	StatusPending   Status = "pending"
+	StatusFailed    Status = "failed"
+)
+
+// Party identifica uma das partes (pagador ou recebedor) de uma transação Pix.
+type Party struct {
+	Name     string `json:"name"`
+	Document string `json:"document"`
+}
+
+// Event é a representação tipada de um evento de webhook Pix (cash in ou cash out),
+// seja ele concluído ou pendente.
+type Event struct {
+	TransactionID string    `json:"transactionId"`
+	Type          EventType `json:"type"`
+	AmountCents   int64     `json:"amountCents"`
+	Payer         Party     `json:"payer"`
+	Payee         Party     `json:"payee"`
+	Timestamp     time.Time `json:"timestamp"`
+	Status        Status    `json:"status"`
+}
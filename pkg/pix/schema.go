@@ -0,0 +1,41 @@
+package pix
+
+// eventJSONSchema é o JSON Schema (draft-07) que descreve Event. É usado tanto para
+// pedir à OpenAI um `response_format: json_schema` estrito quanto para validar a
+// resposta antes de publicá-la.
+const eventJSONSchema = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "title": "PixWebhookEvent",
+  "type": "object",
+  "properties": {
+    "transactionId": { "type": "string", "minLength": 1 },
+    "type": { "type": "string", "enum": ["cash_in", "cash_out"] },
+    "amountCents": { "type": "integer", "minimum": 1 },
+    "payer": {
+      "type": "object",
+      "properties": {
+        "name": { "type": "string" },
+        "document": { "type": "string" }
+      },
+      "required": ["name", "document"]
+    },
+    "payee": {
+      "type": "object",
+      "properties": {
+        "name": { "type": "string" },
+        "document": { "type": "string" }
+      },
+      "required": ["name", "document"]
+    },
+    "timestamp": { "type": "string", "format": "date-time" },
+    "status": { "type": "string", "enum": ["completed", "pending", "failed"] }
+  },
+  "required": ["transactionId", "type", "amountCents", "payer", "payee", "timestamp", "status"],
+  "additionalProperties": false
+}`
+
+// EventJSONSchema retorna o JSON Schema usado para validar e para pedir respostas
+// estritas (`response_format`) de eventos de webhook Pix.
+func EventJSONSchema() string {
+	return eventJSONSchema
+}
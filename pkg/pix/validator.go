@@ -0,0 +1,53 @@
+package pix
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// Validator valida texto bruto retornado pelo modelo contra o JSON Schema de Event
+// e o decodifica para a struct tipada correspondente.
+type Validator struct {
+	schema *gojsonschema.Schema
+}
+
+// NewValidator compila o JSON Schema de Event e retorna um Validator pronto para uso.
+func NewValidator() (*Validator, error) {
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(eventJSONSchema))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao compilar schema do evento Pix: %v", err)
+	}
+	return &Validator{schema: schema}, nil
+}
+
+// Validate recebe o texto bruto retornado pelo modelo, valida contra o schema e,
+// se válido, retorna o Event decodificado e a sua versão canônica em JSON.
+func (v *Validator) Validate(raw string) (*Event, string, error) {
+	result, err := v.schema.Validate(gojsonschema.NewStringLoader(raw))
+	if err != nil {
+		return nil, "", fmt.Errorf("resposta não é um JSON válido: %v", err)
+	}
+
+	if !result.Valid() {
+		var issues []string
+		for _, e := range result.Errors() {
+			issues = append(issues, e.String())
+		}
+		return nil, "", fmt.Errorf("resposta não corresponde ao schema: %s", strings.Join(issues, "; "))
+	}
+
+	var event Event
+	if err := json.Unmarshal([]byte(raw), &event); err != nil {
+		return nil, "", fmt.Errorf("erro ao decodificar evento: %v", err)
+	}
+
+	canonical, err := json.Marshal(event)
+	if err != nil {
+		return nil, "", fmt.Errorf("erro ao recodificar evento: %v", err)
+	}
+
+	return &event, string(canonical), nil
+}
@@ -0,0 +1,198 @@
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/k13w/aiko/pkg/llm"
+	"github.com/k13w/aiko/pkg/pix"
+)
+
+// Config descreve qual gerador usar e para quais sinks fazer fan-out.
+type Config struct {
+	NumWebhooks int             `json:"numWebhooks" yaml:"numWebhooks"`
+	Generator   GeneratorConfig `json:"generator" yaml:"generator"`
+	Sinks       []SinkConfig    `json:"sinks" yaml:"sinks"`
+
+	// Concurrency é o número de webhooks gerados/publicados em paralelo (padrão 1).
+	Concurrency int `json:"concurrency" yaml:"concurrency"`
+	// RatePerSecond limita quantos webhooks podem ser iniciados por segundo (0 = sem limite).
+	RatePerSecond float64 `json:"ratePerSecond" yaml:"ratePerSecond"`
+	// RetryAttempts é o número de tentativas por sink antes de desistir de um webhook.
+	RetryAttempts int `json:"retryAttempts" yaml:"retryAttempts"`
+}
+
+// GeneratorConfig seleciona e parametriza o backend de LLM e o Generator que o usa.
+type GeneratorConfig struct {
+	// Type seleciona o backend de LLM: "openai" (padrão), "azure" ou "ollama".
+	// Para servidores compatíveis com a API da OpenAI, use Type "openai" com Endpoint.
+	Type         string  `json:"type" yaml:"type"`
+	Model        string  `json:"model" yaml:"model"`
+	SystemPrompt string  `json:"systemPrompt" yaml:"systemPrompt"`
+	Endpoint     string  `json:"endpoint" yaml:"endpoint"`
+	Temperature  float64 `json:"temperature" yaml:"temperature"`
+	TopP         float64 `json:"topP" yaml:"topP"`
+	MaxTokens    int     `json:"maxTokens" yaml:"maxTokens"`
+
+	// azure
+	ResourceName string `json:"resourceName" yaml:"resourceName"`
+	Deployment   string `json:"deployment" yaml:"deployment"`
+	APIVersion   string `json:"apiVersion" yaml:"apiVersion"`
+
+	// ValidatePixEvent, quando true, força o modelo a responder com
+	// response_format: json_schema e valida/retenta a resposta como um pix.Event
+	// antes de devolvê-la para publicação.
+	ValidatePixEvent bool `json:"validatePixEvent" yaml:"validatePixEvent"`
+	MaxAttempts      int  `json:"maxAttempts" yaml:"maxAttempts"`
+
+	// Stream, quando true, usa o modo de streaming do backend em vez de esperar a
+	// resposta completa de uma vez.
+	Stream bool `json:"stream" yaml:"stream"`
+}
+
+// SinkConfig seleciona e parametriza um Sink de destino.
+type SinkConfig struct {
+	Type string `json:"type" yaml:"type"` // "sns", "http", "file", "kafka", "rabbitmq"
+
+	// sns
+	TopicArn string `json:"topicArn,omitempty" yaml:"topicArn,omitempty"`
+	Region   string `json:"region,omitempty" yaml:"region,omitempty"`
+
+	// http
+	Endpoint string `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	// SigningScheme seleciona como assinar a requisição: "iugu", "stripe" ou
+	// "generic-hmac". Requer SigningSecretEnv.
+	SigningScheme string `json:"signingScheme,omitempty" yaml:"signingScheme,omitempty"`
+	// SigningSecretEnv é o nome da variável de ambiente que contém o segredo HMAC.
+	SigningSecretEnv string `json:"signingSecretEnv,omitempty" yaml:"signingSecretEnv,omitempty"`
+
+	// file
+	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+
+	// kafka
+	Brokers []string `json:"brokers,omitempty" yaml:"brokers,omitempty"`
+	Topic   string   `json:"topic,omitempty" yaml:"topic,omitempty"`
+
+	// rabbitmq
+	URL   string `json:"url,omitempty" yaml:"url,omitempty"`
+	Queue string `json:"queue,omitempty" yaml:"queue,omitempty"`
+}
+
+// LoadConfig lê um arquivo de configuração YAML ou JSON (detectado pela extensão).
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao ler config %s: %v", path, err)
+	}
+
+	var cfg Config
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return nil, fmt.Errorf("erro ao parsear config JSON: %v", err)
+		}
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return nil, fmt.Errorf("erro ao parsear config YAML: %v", err)
+		}
+	default:
+		return nil, fmt.Errorf("extensão de config não suportada: %s", path)
+	}
+
+	if cfg.NumWebhooks <= 0 {
+		cfg.NumWebhooks = 1
+	}
+
+	return &cfg, nil
+}
+
+// BuildGenerator constrói o Generator descrito por GeneratorConfig, falando com o
+// backend de LLM selecionado via pkg/llm.
+func BuildGenerator(cfg GeneratorConfig, apiKey string) (Generator, error) {
+	client, err := llm.NewClient(llm.Config{
+		Type:         cfg.Type,
+		APIKey:       apiKey,
+		Model:        cfg.Model,
+		Endpoint:     cfg.Endpoint,
+		ResourceName: cfg.ResourceName,
+		Deployment:   cfg.Deployment,
+		APIVersion:   cfg.APIVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genCfg := LLMGeneratorConfig{
+		SystemPrompt: cfg.SystemPrompt,
+		Temperature:  cfg.Temperature,
+		TopP:         cfg.TopP,
+		MaxTokens:    cfg.MaxTokens,
+		Stream:       cfg.Stream,
+	}
+
+	if cfg.ValidatePixEvent {
+		genCfg.JSONSchema = []byte(pix.EventJSONSchema())
+		genCfg.JSONSchemaName = "pix_webhook_event"
+	}
+
+	var gen Generator = NewLLMGenerator(client, genCfg)
+
+	if cfg.ValidatePixEvent {
+		validator, err := pix.NewValidator()
+		if err != nil {
+			return nil, err
+		}
+		gen = NewValidatingGenerator(gen, validator, cfg.MaxAttempts)
+	}
+
+	return gen, nil
+}
+
+// BuildSinks constrói todos os Sinks descritos em Config, na ordem em que aparecem.
+func BuildSinks(cfgs []SinkConfig) ([]Sink, error) {
+	sinks := make([]Sink, 0, len(cfgs))
+	for _, c := range cfgs {
+		sink, err := buildSink(c)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	return sinks, nil
+}
+
+func buildSink(c SinkConfig) (Sink, error) {
+	switch c.Type {
+	case "sns":
+		return NewSNSSink(c.TopicArn, c.Region)
+	case "http":
+		if c.SigningScheme == "" && c.SigningSecretEnv == "" {
+			return NewHTTPSink(c.Endpoint), nil
+		}
+		scheme, err := LookupSigningScheme(c.SigningScheme)
+		if err != nil {
+			return nil, err
+		}
+		if c.SigningSecretEnv == "" {
+			return nil, fmt.Errorf("sink http %s: signingScheme %q exige signingSecretEnv", c.Endpoint, scheme.Name)
+		}
+		secret := os.Getenv(c.SigningSecretEnv)
+		if secret == "" {
+			return nil, fmt.Errorf("sink http %s: variável de ambiente %s (signingSecretEnv) não definida ou vazia", c.Endpoint, c.SigningSecretEnv)
+		}
+		return NewSignedHTTPSink(c.Endpoint, secret, scheme), nil
+	case "file":
+		return NewFileSink(c.Path), nil
+	case "kafka":
+		return NewKafkaSink(c.Brokers, c.Topic), nil
+	case "rabbitmq":
+		return NewRabbitMQSink(c.URL, c.Queue)
+	default:
+		return nil, fmt.Errorf("tipo de sink desconhecido: %s", c.Type)
+	}
+}
@@ -0,0 +1,82 @@
+package simulator
+
+import "github.com/k13w/aiko/pkg/llm"
+
+// Generator produz o texto de um webhook a partir de um prompt.
+type Generator interface {
+	Generate(prompt string) (string, error)
+}
+
+// ChunkGenerator é implementado por Generators que, em modo streaming, conseguem
+// repassar cada trecho parcial da resposta para um callback passado por chamada.
+// O callback é um parâmetro de GenerateWithChunk, não um campo mutável do
+// Generator, para que a mesma instância possa ser compartilhada com segurança
+// entre goroutines concorrentes (ver RunConcurrent). ValidatingGenerator usa isso
+// para começar a validar a resposta contra o schema do evento assim que ela
+// chega, em vez de esperar o fim do stream, e para interromper o stream assim
+// que uma resposta válida é reconhecida.
+type ChunkGenerator interface {
+	GenerateWithChunk(prompt string, onChunk func(partial string) (stop bool)) (string, error)
+}
+
+// LLMGeneratorConfig parametriza um LLMGenerator, independente do backend de LLM usado.
+type LLMGeneratorConfig struct {
+	SystemPrompt string
+	Temperature  float64
+	TopP         float64
+	MaxTokens    int
+
+	// JSONSchema, se preenchido, pede ao backend uma resposta em JSON estrito
+	// seguindo esse schema (ver pix.EventJSONSchema), quando suportado.
+	JSONSchema     []byte
+	JSONSchemaName string
+
+	// Stream, se true, usa o modo de streaming do backend em vez de esperar a
+	// resposta completa de uma vez.
+	Stream bool
+}
+
+// LLMGenerator gera webhooks chamando um llm.Client configurável (OpenAI, Azure
+// OpenAI, Ollama ou qualquer servidor compatível com a API da OpenAI), sem ficar
+// amarrado a um único provedor.
+type LLMGenerator struct {
+	client llm.Client
+	cfg    LLMGeneratorConfig
+}
+
+// NewLLMGenerator cria um Generator que fala com client usando cfg.
+func NewLLMGenerator(client llm.Client, cfg LLMGeneratorConfig) *LLMGenerator {
+	if cfg.SystemPrompt == "" {
+		cfg.SystemPrompt = "Você é um simulador de webhooks de Pix. Gere os eventos com IDs e valores fictícios."
+	}
+	return &LLMGenerator{client: client, cfg: cfg}
+}
+
+func (g *LLMGenerator) Generate(prompt string) (string, error) {
+	return g.GenerateWithChunk(prompt, nil)
+}
+
+// GenerateWithChunk é igual a Generate, mas, quando cfg.Stream está ativo, chama
+// onChunk a cada trecho parcial recebido (satisfazendo ChunkGenerator). onChunk
+// pode retornar true para interromper o stream assim que estiver satisfeito com
+// o trecho recebido até ali. onChunk é um parâmetro local à chamada, então
+// múltiplas goroutines podem chamar GenerateWithChunk concorrentemente na mesma
+// instância sem interferir umas nas outras.
+func (g *LLMGenerator) GenerateWithChunk(prompt string, onChunk func(partial string) (stop bool)) (string, error) {
+	req := llm.ChatRequest{
+		Messages: []llm.Message{
+			{Role: "system", Content: g.cfg.SystemPrompt},
+			{Role: "user", Content: prompt},
+		},
+		Temperature:    g.cfg.Temperature,
+		TopP:           g.cfg.TopP,
+		MaxTokens:      g.cfg.MaxTokens,
+		ResponseSchema: g.cfg.JSONSchema,
+		SchemaName:     g.cfg.JSONSchemaName,
+	}
+
+	if g.cfg.Stream {
+		return g.client.ChatStream(req, onChunk)
+	}
+	return g.client.Chat(req)
+}
@@ -0,0 +1,76 @@
+package simulator
+
+import (
+	"fmt"
+
+	"github.com/k13w/aiko/pkg/pix"
+)
+
+// ValidatingGenerator envolve outro Generator e garante que a resposta seja um
+// Event Pix válido antes de ser devolvida para publicação. Respostas malformadas
+// são retentadas com o erro de validação realimentado no prompt. Quando Inner
+// implementa ChunkGenerator (streaming), cada trecho parcial já é validado contra
+// o schema assim que chega, e o stream é interrompido assim que um trecho válido
+// é encontrado, em vez de esperar o fim do stream para validar a resposta
+// completa. O callback de chunk é passado por chamada (não guardado em campo),
+// então uma mesma instância de ValidatingGenerator/Inner pode ser compartilhada
+// com segurança entre goroutines concorrentes (ver RunConcurrent).
+type ValidatingGenerator struct {
+	Inner       Generator
+	Validator   *pix.Validator
+	MaxAttempts int
+}
+
+// NewValidatingGenerator cria um ValidatingGenerator. maxAttempts <= 0 usa o padrão de 3.
+func NewValidatingGenerator(inner Generator, validator *pix.Validator, maxAttempts int) *ValidatingGenerator {
+	if maxAttempts <= 0 {
+		maxAttempts = 3
+	}
+	return &ValidatingGenerator{Inner: inner, Validator: validator, MaxAttempts: maxAttempts}
+}
+
+func (g *ValidatingGenerator) Generate(prompt string) (string, error) {
+	currentPrompt := prompt
+	var lastErr error
+
+	chunkGen, streaming := g.Inner.(ChunkGenerator)
+
+	for attempt := 1; attempt <= g.MaxAttempts; attempt++ {
+		var earlyCanonical string
+		var raw string
+		var err error
+
+		if streaming {
+			raw, err = chunkGen.GenerateWithChunk(currentPrompt, func(partial string) bool {
+				if _, canonical, verr := g.Validator.Validate(partial); verr == nil {
+					earlyCanonical = canonical
+					return true
+				}
+				return false
+			})
+		} else {
+			raw, err = g.Inner.Generate(currentPrompt)
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		if earlyCanonical != "" {
+			return earlyCanonical, nil
+		}
+
+		_, canonical, err := g.Validator.Validate(raw)
+		if err == nil {
+			return canonical, nil
+		}
+
+		lastErr = err
+		currentPrompt = fmt.Sprintf(
+			"%s\n\nSua resposta anterior foi rejeitada: %v. Responda novamente apenas com o JSON do evento, sem texto adicional.",
+			prompt, err,
+		)
+	}
+
+	return "", fmt.Errorf("resposta inválida após %d tentativas: %v", g.MaxAttempts, lastErr)
+}
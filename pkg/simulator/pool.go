@@ -0,0 +1,151 @@
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RunOptions controla o driver concorrente de geração/publicação de webhooks.
+type RunOptions struct {
+	// Concurrency é o número de workers gerando e publicando webhooks em paralelo.
+	Concurrency int
+	// RatePerSecond limita quantos webhooks podem ser iniciados por segundo (0 = sem limite).
+	RatePerSecond float64
+	// RetryAttempts é o número de tentativas por sink antes de desistir de um webhook.
+	RetryAttempts int
+	// RetryBaseDelay é o atraso inicial do backoff exponencial entre tentativas.
+	RetryBaseDelay time.Duration
+
+	// Recorder, se definido, grava cada prompt/resposta/resultado de publicação
+	// para permitir replays determinísticos posteriores (ver Replay).
+	Recorder *Recorder
+	// RecordHeaders são os cabeçalhos da chamada ao modelo incluídos na gravação
+	// (com segredos como Authorization redigidos pelo próprio Recorder).
+	RecordHeaders map[string]string
+}
+
+func (o RunOptions) withDefaults() RunOptions {
+	if o.Concurrency <= 0 {
+		o.Concurrency = 1
+	}
+	if o.RetryAttempts <= 0 {
+		o.RetryAttempts = 1
+	}
+	if o.RetryBaseDelay <= 0 {
+		o.RetryBaseDelay = 200 * time.Millisecond
+	}
+	return o
+}
+
+// RunConcurrent gera numWebhooks webhooks (ciclando pela lista de prompts) usando um
+// pool de workers, publicando cada um em todos os sinks (fan-out), e retorna um
+// Report com sucessos, falhas e percentis de latência. A execução para assim que
+// ctx é cancelado.
+func RunConcurrent(ctx context.Context, gen Generator, sinks []Sink, prompts []string, numWebhooks int, opts RunOptions) *Report {
+	opts = opts.withDefaults()
+	report := newReport()
+
+	var limiter *rate.Limiter
+	if opts.RatePerSecond > 0 {
+		limiter = rate.NewLimiter(rate.Limit(opts.RatePerSecond), 1)
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < opts.Concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if ctx.Err() != nil {
+					return
+				}
+				if limiter != nil {
+					if err := limiter.Wait(ctx); err != nil {
+						return
+					}
+				}
+				processWebhook(ctx, gen, sinks, prompts[i%len(prompts)], opts, report)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		for i := 0; i < numWebhooks; i++ {
+			select {
+			case jobs <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	wg.Wait()
+	return report
+}
+
+func processWebhook(ctx context.Context, gen Generator, sinks []Sink, prompt string, opts RunOptions, report *Report) {
+	start := time.Now()
+
+	webhookText, err := gen.Generate(prompt)
+	if err != nil {
+		fmt.Println("Erro ao gerar webhook:", err)
+		report.recordFailure()
+		return
+	}
+
+	fmt.Printf("\nWebhook gerado:\n%s\n\n", webhookText)
+
+	ok := true
+	sinkResults := make([]SinkResult, 0, len(sinks))
+	for _, sink := range sinks {
+		result := SinkResult{Target: sink.Name(), Status: "ok"}
+		if err := sendWithRetry(ctx, sink, webhookText, opts.RetryAttempts, opts.RetryBaseDelay); err != nil {
+			fmt.Printf("Erro ao publicar webhook no sink %s: %v\n", sink.Name(), err)
+			result.Status = "error"
+			result.Error = err.Error()
+			ok = false
+		}
+		sinkResults = append(sinkResults, result)
+	}
+
+	if opts.Recorder != nil {
+		opts.Recorder.Record(prompt, webhookText, opts.RecordHeaders, sinkResults)
+	}
+
+	if ok {
+		report.recordSuccess(time.Since(start))
+	} else {
+		report.recordFailure()
+	}
+}
+
+// sendWithRetry envia o webhook ao sink, retentando com backoff exponencial em caso de erro.
+func sendWithRetry(ctx context.Context, sink Sink, webhookText string, attempts int, baseDelay time.Duration) error {
+	var lastErr error
+
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			delay := baseDelay * time.Duration(1<<uint(attempt-1))
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		if err := sink.Send(webhookText); err != nil {
+			lastErr = err
+			continue
+		}
+		return nil
+	}
+
+	return fmt.Errorf("esgotadas %d tentativas: %v", attempts, lastErr)
+}
@@ -0,0 +1,127 @@
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// redactedHeaders lista, em minúsculas, os cabeçalhos que nunca devem ser gravados
+// em texto puro em um arquivo de gravação.
+var redactedHeaders = map[string]bool{
+	"authorization": true,
+}
+
+// SinkResult registra o resultado da publicação de um webhook em um sink específico.
+type SinkResult struct {
+	Target string `json:"target"`
+	Status string `json:"status"` // "ok" ou "error"
+	Error  string `json:"error,omitempty"`
+}
+
+// HAREntry é um registro de uma rodada de geração: o prompt usado, a resposta do
+// modelo e o resultado da publicação em cada sink. O formato é inspirado no HAR
+// (HTTP Archive), mas simplificado para o que o simulador precisa reproduzir.
+type HAREntry struct {
+	Prompt         string            `json:"prompt"`
+	Response       string            `json:"response"`
+	RequestHeaders map[string]string `json:"requestHeaders,omitempty"`
+	SinkResults    []SinkResult      `json:"sinkResults"`
+}
+
+// HARFile é o conteúdo serializado de uma gravação: uma lista de HAREntry.
+type HARFile struct {
+	Entries []HAREntry `json:"entries"`
+}
+
+// Recorder acumula HAREntry em memória e grava tudo em disco em um único arquivo
+// JSON ao final da execução (via Flush).
+type Recorder struct {
+	mu   sync.Mutex
+	path string
+	file HARFile
+}
+
+// NewRecorder cria um Recorder que grava no caminho informado quando Flush for chamado.
+func NewRecorder(path string) *Recorder {
+	return &Recorder{path: path}
+}
+
+// Record adiciona uma entrada à gravação, redigindo cabeçalhos sensíveis (ex.: Authorization).
+func (r *Recorder) Record(prompt, response string, headers map[string]string, results []SinkResult) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.file.Entries = append(r.file.Entries, HAREntry{
+		Prompt:         prompt,
+		Response:       response,
+		RequestHeaders: redactHeaders(headers),
+		SinkResults:    results,
+	})
+}
+
+// Flush grava todas as entradas acumuladas no arquivo de destino como JSON indentado.
+func (r *Recorder) Flush() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := json.MarshalIndent(r.file, "", "  ")
+	if err != nil {
+		return fmt.Errorf("erro ao serializar gravação: %v", err)
+	}
+
+	if err := os.WriteFile(r.path, data, 0644); err != nil {
+		return fmt.Errorf("erro ao gravar %s: %v", r.path, err)
+	}
+
+	return nil
+}
+
+func redactHeaders(headers map[string]string) map[string]string {
+	if headers == nil {
+		return nil
+	}
+
+	redacted := make(map[string]string, len(headers))
+	for k, v := range headers {
+		if redactedHeaders[strings.ToLower(k)] {
+			redacted[k] = "REDACTED"
+			continue
+		}
+		redacted[k] = v
+	}
+	return redacted
+}
+
+// LoadHARFile lê e decodifica um arquivo de gravação gerado por Recorder.Flush.
+func LoadHARFile(path string) (*HARFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao ler gravação %s: %v", path, err)
+	}
+
+	var har HARFile
+	if err := json.Unmarshal(data, &har); err != nil {
+		return nil, fmt.Errorf("erro ao parsear gravação %s: %v", path, err)
+	}
+
+	return &har, nil
+}
+
+// FilterByPrompt retorna apenas as entradas cujo prompt contém o template informado.
+// Um template vazio não filtra nada.
+func (h *HARFile) FilterByPrompt(template string) []HAREntry {
+	if template == "" {
+		return h.Entries
+	}
+
+	var filtered []HAREntry
+	for _, e := range h.Entries {
+		if strings.Contains(e.Prompt, template) {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered
+}
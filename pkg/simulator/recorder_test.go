@@ -0,0 +1,92 @@
+package simulator
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestRecorderRedactsAuthorization(t *testing.T) {
+	rec := NewRecorder(filepath.Join(t.TempDir(), "rec.json"))
+	rec.Record("prompt", "response", map[string]string{
+		"Authorization": "Bearer secreto",
+		"Content-Type":  "application/json",
+	}, nil)
+
+	if err := rec.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	har, err := LoadHARFile(rec.path)
+	if err != nil {
+		t.Fatalf("LoadHARFile: %v", err)
+	}
+
+	if len(har.Entries) != 1 {
+		t.Fatalf("esperava 1 entrada, veio %d", len(har.Entries))
+	}
+
+	got := har.Entries[0].RequestHeaders
+	if got["Authorization"] != "REDACTED" {
+		t.Errorf("Authorization = %q, queria REDACTED", got["Authorization"])
+	}
+	if got["Content-Type"] != "application/json" {
+		t.Errorf("Content-Type = %q, não deveria ter sido redigido", got["Content-Type"])
+	}
+}
+
+// recordingSink guarda os webhooks recebidos em memória, para testar Replay sem rede.
+type recordingSink struct {
+	received []string
+}
+
+func (s *recordingSink) Name() string { return "recording" }
+
+func (s *recordingSink) Send(webhookText string) error {
+	s.received = append(s.received, webhookText)
+	return nil
+}
+
+func TestReplayRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rec.json")
+	rec := NewRecorder(path)
+	rec.Record("gere um evento A", "{\"transactionId\":\"A\"}", nil, []SinkResult{{Target: "http:x", Status: "ok"}})
+	rec.Record("gere um evento B", "{\"transactionId\":\"B\"}", nil, []SinkResult{{Target: "http:x", Status: "ok"}})
+
+	if err := rec.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	sink := &recordingSink{}
+	report, err := Replay(context.Background(), path, "", []Sink{sink}, RunOptions{})
+	if err != nil {
+		t.Fatalf("Replay: %v", err)
+	}
+
+	if report.Successes != 2 {
+		t.Errorf("Successes = %d, queria 2", report.Successes)
+	}
+	if len(sink.received) != 2 || sink.received[0] != "{\"transactionId\":\"A\"}" || sink.received[1] != "{\"transactionId\":\"B\"}" {
+		t.Errorf("sink recebeu %v, inesperado", sink.received)
+	}
+}
+
+func TestReplayFiltersByPrompt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rec.json")
+	rec := NewRecorder(path)
+	rec.Record("gere um evento de PIX recebido", "{\"transactionId\":\"A\"}", nil, nil)
+	rec.Record("gere um evento de PIX estornado", "{\"transactionId\":\"B\"}", nil, nil)
+
+	if err := rec.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	sink := &recordingSink{}
+	if _, err := Replay(context.Background(), path, "estornado", []Sink{sink}, RunOptions{}); err != nil {
+		t.Fatalf("Replay: %v", err)
+	}
+
+	if len(sink.received) != 1 || sink.received[0] != "{\"transactionId\":\"B\"}" {
+		t.Errorf("sink recebeu %v, queria só a entrada filtrada por 'estornado'", sink.received)
+	}
+}
@@ -0,0 +1,46 @@
+package simulator
+
+import (
+	"context"
+	"fmt"
+)
+
+// Replay carrega um arquivo de gravação e republica cada resposta registrada nos
+// sinks informados, sem chamar o Generator. Útil para reruns determinísticos e
+// sem custo contra ambientes de staging. promptFilter, se não vazio, restringe a
+// reprodução às entradas cujo prompt contém esse template.
+func Replay(ctx context.Context, path, promptFilter string, sinks []Sink, opts RunOptions) (*Report, error) {
+	opts = opts.withDefaults()
+
+	har, err := LoadHARFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := har.FilterByPrompt(promptFilter)
+	report := newReport()
+
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			break
+		}
+
+		fmt.Printf("\nReproduzindo webhook gravado:\n%s\n\n", entry.Response)
+
+		ok := true
+		for _, sink := range sinks {
+			if err := sendWithRetry(ctx, sink, entry.Response, opts.RetryAttempts, opts.RetryBaseDelay); err != nil {
+				fmt.Printf("Erro ao publicar webhook no sink %s: %v\n", sink.Name(), err)
+				ok = false
+			}
+		}
+
+		if ok {
+			report.recordSuccess(0)
+		} else {
+			report.recordFailure()
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,59 @@
+package simulator
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Report acumula o resultado de uma rodada de geração e publicação de webhooks,
+// para ser impresso como um resumo ao final da execução.
+type Report struct {
+	mu        sync.Mutex
+	Successes int
+	Failures  int
+	Latencies []time.Duration
+}
+
+func newReport() *Report {
+	return &Report{}
+}
+
+func (r *Report) recordSuccess(latency time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Successes++
+	r.Latencies = append(r.Latencies, latency)
+}
+
+func (r *Report) recordFailure() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Failures++
+}
+
+// Percentile retorna a latência no percentil p (0-100) entre as execuções bem-sucedidas.
+func (r *Report) Percentile(p float64) time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.Latencies) == 0 {
+		return 0
+	}
+
+	sorted := append([]time.Duration(nil), r.Latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	idx := int(p / 100 * float64(len(sorted)-1))
+	return sorted[idx]
+}
+
+// String formata um resumo legível do relatório: sucessos, falhas e percentis de latência.
+func (r *Report) String() string {
+	return fmt.Sprintf(
+		"Resumo: %d sucesso(s), %d falha(s), latência p50=%s p95=%s p99=%s",
+		r.Successes, r.Failures,
+		r.Percentile(50), r.Percentile(95), r.Percentile(99),
+	)
+}
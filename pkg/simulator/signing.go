@@ -0,0 +1,105 @@
+package simulator
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// SigningScheme descreve como assinar um webhook HTTP de saída, imitando o que um
+// provedor Pix real (Iugu, PagSeguro, Mercado Pago) faz para que o consumidor possa
+// verificar a autenticidade e rejeitar replays.
+type SigningScheme struct {
+	Name string
+
+	SignatureHeader   string
+	TimestampHeader   string
+	IdempotencyHeader string
+
+	// signedPayload monta os bytes que são efetivamente assinados. Se nil, assina o
+	// corpo puro.
+	signedPayload func(timestamp string, body []byte) []byte
+	// formatSignature formata o header de assinatura a partir do timestamp e do
+	// digest em hex. Se nil, usa "sha256=<hex>".
+	formatSignature func(timestamp, hexDigest string) string
+}
+
+var (
+	// SigningSchemeIugu imita o esquema de assinatura da Iugu: HMAC-SHA256 do corpo,
+	// enviado como "sha256=<hex>".
+	SigningSchemeIugu = SigningScheme{
+		Name:              "iugu",
+		SignatureHeader:   "X-Hub-Signature",
+		TimestampHeader:   "X-Timestamp",
+		IdempotencyHeader: "X-Idempotency-Key",
+	}
+
+	// SigningSchemeStripe imita o esquema de assinatura da Stripe: assina
+	// "<timestamp>.<corpo>" e envia "t=<timestamp>,v1=<hex>" em Stripe-Signature.
+	SigningSchemeStripe = SigningScheme{
+		Name:              "stripe",
+		SignatureHeader:   "Stripe-Signature",
+		TimestampHeader:   "X-Timestamp",
+		IdempotencyHeader: "X-Idempotency-Key",
+		signedPayload: func(timestamp string, body []byte) []byte {
+			return []byte(timestamp + "." + string(body))
+		},
+		formatSignature: func(timestamp, hexDigest string) string {
+			return fmt.Sprintf("t=%s,v1=%s", timestamp, hexDigest)
+		},
+	}
+
+	// SigningSchemeGenericHMAC é um esquema HMAC-SHA256 simples sobre o corpo, na
+	// forma "sha256=<hex>" em X-Signature.
+	SigningSchemeGenericHMAC = SigningScheme{
+		Name:              "generic-hmac",
+		SignatureHeader:   "X-Signature",
+		TimestampHeader:   "X-Timestamp",
+		IdempotencyHeader: "X-Idempotency-Key",
+	}
+)
+
+// LookupSigningScheme resolve o nome de um --signing-scheme para o preset correspondente.
+func LookupSigningScheme(name string) (SigningScheme, error) {
+	switch name {
+	case "", "generic-hmac":
+		return SigningSchemeGenericHMAC, nil
+	case "iugu":
+		return SigningSchemeIugu, nil
+	case "stripe":
+		return SigningSchemeStripe, nil
+	default:
+		return SigningScheme{}, fmt.Errorf("esquema de assinatura desconhecido: %s", name)
+	}
+}
+
+// Sign calcula a assinatura HMAC-SHA256 do payload com o secret informado e retorna
+// os cabeçalhos a adicionar na requisição. idempotencyKey é omitido quando vazio.
+func (s SigningScheme) Sign(secret string, body []byte, idempotencyKey string) map[string]string {
+	timestamp := fmt.Sprintf("%d", time.Now().Unix())
+
+	payload := body
+	if s.signedPayload != nil {
+		payload = s.signedPayload(timestamp, body)
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	digest := hex.EncodeToString(mac.Sum(nil))
+
+	signature := "sha256=" + digest
+	if s.formatSignature != nil {
+		signature = s.formatSignature(timestamp, digest)
+	}
+
+	headers := map[string]string{
+		s.SignatureHeader: signature,
+		s.TimestampHeader: timestamp,
+	}
+	if idempotencyKey != "" && s.IdempotencyHeader != "" {
+		headers[s.IdempotencyHeader] = idempotencyKey
+	}
+	return headers
+}
@@ -0,0 +1,92 @@
+package simulator
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestSigningSchemeGenericHMAC(t *testing.T) {
+	secret := "segredo"
+	body := []byte(`{"transactionId":"A"}`)
+
+	headers := SigningSchemeGenericHMAC.Sign(secret, body, "")
+
+	sig, ok := headers["X-Signature"]
+	if !ok {
+		t.Fatalf("header X-Signature ausente: %v", headers)
+	}
+	if !strings.HasPrefix(sig, "sha256=") {
+		t.Fatalf("X-Signature = %q, queria prefixo sha256=", sig)
+	}
+
+	wantDigest := hmacHex(secret, body)
+	if sig != "sha256="+wantDigest {
+		t.Errorf("X-Signature = %q, queria sha256=%s", sig, wantDigest)
+	}
+
+	if _, ok := headers["X-Idempotency-Key"]; ok {
+		t.Errorf("X-Idempotency-Key não deveria aparecer quando idempotencyKey é vazio")
+	}
+}
+
+func TestSigningSchemeIuguIdempotencyKey(t *testing.T) {
+	headers := SigningSchemeIugu.Sign("segredo", []byte("corpo"), "txn-123")
+
+	if headers["X-Idempotency-Key"] != "txn-123" {
+		t.Errorf("X-Idempotency-Key = %q, queria txn-123", headers["X-Idempotency-Key"])
+	}
+	if _, ok := headers["X-Hub-Signature"]; !ok {
+		t.Errorf("header X-Hub-Signature ausente: %v", headers)
+	}
+}
+
+func TestSigningSchemeStripeFormat(t *testing.T) {
+	secret := "segredo"
+	body := []byte(`{"transactionId":"A"}`)
+
+	headers := SigningSchemeStripe.Sign(secret, body, "")
+
+	sig, ok := headers["Stripe-Signature"]
+	if !ok {
+		t.Fatalf("header Stripe-Signature ausente: %v", headers)
+	}
+
+	timestamp := headers["X-Timestamp"]
+	wantDigest := hmacHex(secret, []byte(timestamp+"."+string(body)))
+	want := "t=" + timestamp + ",v1=" + wantDigest
+	if sig != want {
+		t.Errorf("Stripe-Signature = %q, queria %q", sig, want)
+	}
+}
+
+func TestLookupSigningScheme(t *testing.T) {
+	cases := map[string]string{
+		"":             "generic-hmac",
+		"generic-hmac": "generic-hmac",
+		"iugu":         "iugu",
+		"stripe":       "stripe",
+	}
+	for name, wantName := range cases {
+		scheme, err := LookupSigningScheme(name)
+		if err != nil {
+			t.Errorf("LookupSigningScheme(%q): %v", name, err)
+			continue
+		}
+		if scheme.Name != wantName {
+			t.Errorf("LookupSigningScheme(%q).Name = %q, queria %q", name, scheme.Name, wantName)
+		}
+	}
+
+	if _, err := LookupSigningScheme("bogus"); err == nil {
+		t.Error("LookupSigningScheme(\"bogus\") deveria retornar erro")
+	}
+}
+
+func hmacHex(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
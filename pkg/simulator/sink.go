@@ -0,0 +1,9 @@
+package simulator
+
+// Sink representa um destino para onde um webhook gerado pode ser publicado.
+type Sink interface {
+	// Name identifica o sink nos logs e relatórios.
+	Name() string
+	// Send publica o texto do webhook no destino. Erros são responsabilidade do chamador tratar.
+	Send(webhookText string) error
+}
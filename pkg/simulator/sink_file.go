@@ -0,0 +1,40 @@
+package simulator
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// FileSink grava cada webhook gerado em um arquivo (ou stdout, quando Path é vazio).
+type FileSink struct {
+	Path string
+}
+
+// NewFileSink cria um FileSink para o caminho informado. Um Path vazio escreve em stdout.
+func NewFileSink(path string) *FileSink {
+	return &FileSink{Path: path}
+}
+
+func (s *FileSink) Name() string {
+	if s.Path == "" {
+		return "file:stdout"
+	}
+	return "file:" + s.Path
+}
+
+func (s *FileSink) Send(webhookText string) error {
+	var w io.Writer = os.Stdout
+
+	if s.Path != "" {
+		f, err := os.OpenFile(s.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return fmt.Errorf("erro ao abrir arquivo %s: %v", s.Path, err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	_, err := fmt.Fprintf(w, "%s\n", webhookText)
+	return err
+}
@@ -0,0 +1,72 @@
+package simulator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// HTTPSink envia o webhook via POST para um endpoint HTTP local ou remoto. Quando
+// Secret está preenchido, assina a requisição conforme Scheme, imitando provedores
+// reais (Iugu, Stripe, etc.) para que o consumidor possa verificar autenticidade e
+// rejeitar replays.
+type HTTPSink struct {
+	Endpoint string
+	Secret   string
+	Scheme   SigningScheme
+
+	client *http.Client
+}
+
+// NewHTTPSink cria um HTTPSink sem assinatura, apontando para o endpoint informado.
+func NewHTTPSink(endpoint string) *HTTPSink {
+	return &HTTPSink{Endpoint: endpoint, client: &http.Client{}}
+}
+
+// NewSignedHTTPSink cria um HTTPSink que assina cada requisição com HMAC-SHA256
+// usando secret, seguindo o esquema informado.
+func NewSignedHTTPSink(endpoint, secret string, scheme SigningScheme) *HTTPSink {
+	return &HTTPSink{Endpoint: endpoint, Secret: secret, Scheme: scheme, client: &http.Client{}}
+}
+
+func (s *HTTPSink) Name() string {
+	return "http:" + s.Endpoint
+}
+
+func (s *HTTPSink) Send(webhookText string) error {
+	body := []byte(webhookText)
+
+	req, err := http.NewRequest("POST", s.Endpoint, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if s.Secret != "" {
+		for header, value := range s.Scheme.Sign(s.Secret, body, transactionIDOf(webhookText)) {
+			req.Header.Set(header, value)
+		}
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	fmt.Printf("Webhook enviado! Status code: %d\n", resp.StatusCode)
+	return nil
+}
+
+// transactionIDOf extrai o campo transactionId do evento Pix, se o webhook for um
+// JSON válido no formato esperado. Usado para derivar o X-Idempotency-Key.
+func transactionIDOf(webhookText string) string {
+	var partial struct {
+		TransactionID string `json:"transactionId"`
+	}
+	if err := json.Unmarshal([]byte(webhookText), &partial); err != nil {
+		return ""
+	}
+	return partial.TransactionID
+}
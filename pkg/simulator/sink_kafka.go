@@ -0,0 +1,45 @@
+package simulator
+
+import (
+	"context"
+	"fmt"
+
+	kafka "github.com/segmentio/kafka-go"
+)
+
+// KafkaSink publica o webhook em um tópico Kafka.
+type KafkaSink struct {
+	Brokers []string
+	Topic   string
+
+	writer *kafka.Writer
+}
+
+// NewKafkaSink cria um KafkaSink para o tópico e brokers informados.
+func NewKafkaSink(brokers []string, topic string) *KafkaSink {
+	return &KafkaSink{
+		Brokers: brokers,
+		Topic:   topic,
+		writer: &kafka.Writer{
+			Addr:     kafka.TCP(brokers...),
+			Topic:    topic,
+			Balancer: &kafka.LeastBytes{},
+		},
+	}
+}
+
+func (s *KafkaSink) Name() string {
+	return "kafka:" + s.Topic
+}
+
+func (s *KafkaSink) Send(webhookText string) error {
+	err := s.writer.WriteMessages(context.Background(), kafka.Message{
+		Value: []byte(webhookText),
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao publicar no Kafka: %v", err)
+	}
+
+	fmt.Printf("Webhook publicado no Kafka! Tópico: %s\n", s.Topic)
+	return nil
+}
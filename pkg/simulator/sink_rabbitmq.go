@@ -0,0 +1,55 @@
+package simulator
+
+import (
+	"fmt"
+
+	"github.com/streadway/amqp"
+)
+
+// RabbitMQSink publica o webhook em uma fila do RabbitMQ.
+type RabbitMQSink struct {
+	URL   string
+	Queue string
+
+	conn    *amqp.Connection
+	channel *amqp.Channel
+}
+
+// NewRabbitMQSink conecta em uma instância do RabbitMQ e declara a fila informada.
+func NewRabbitMQSink(url, queue string) (*RabbitMQSink, error) {
+	conn, err := amqp.Dial(url)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao conectar no RabbitMQ: %v", err)
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("erro ao abrir canal do RabbitMQ: %v", err)
+	}
+
+	if _, err := ch.QueueDeclare(queue, true, false, false, false, nil); err != nil {
+		ch.Close()
+		conn.Close()
+		return nil, fmt.Errorf("erro ao declarar fila %s: %v", queue, err)
+	}
+
+	return &RabbitMQSink{URL: url, Queue: queue, conn: conn, channel: ch}, nil
+}
+
+func (s *RabbitMQSink) Name() string {
+	return "rabbitmq:" + s.Queue
+}
+
+func (s *RabbitMQSink) Send(webhookText string) error {
+	err := s.channel.Publish("", s.Queue, false, false, amqp.Publishing{
+		ContentType: "application/json",
+		Body:        []byte(webhookText),
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao publicar no RabbitMQ: %v", err)
+	}
+
+	fmt.Printf("Webhook publicado no RabbitMQ! Fila: %s\n", s.Queue)
+	return nil
+}
@@ -0,0 +1,53 @@
+package simulator
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sns"
+)
+
+// SNSSink publica o webhook em um tópico SNS da AWS.
+type SNSSink struct {
+	TopicArn string
+	Region   string
+
+	client *sns.SNS
+}
+
+// NewSNSSink cria um SNSSink para o tópico e região informados.
+func NewSNSSink(topicArn, region string) (*SNSSink, error) {
+	sess, err := session.NewSession(&aws.Config{
+		Region: aws.String(region),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao criar sessão AWS: %v", err)
+	}
+
+	return &SNSSink{
+		TopicArn: topicArn,
+		Region:   region,
+		client:   sns.New(sess),
+	}, nil
+}
+
+func (s *SNSSink) Name() string {
+	return "sns:" + s.TopicArn
+}
+
+func (s *SNSSink) Send(webhookText string) error {
+	input := &sns.PublishInput{
+		TopicArn: aws.String(s.TopicArn),
+		Message:  aws.String(webhookText),
+		Subject:  aws.String("Webhook PIX Simulado"),
+	}
+
+	result, err := s.client.Publish(input)
+	if err != nil {
+		return fmt.Errorf("erro ao publicar no SNS: %v", err)
+	}
+
+	fmt.Printf("Webhook publicado no SNS! MessageId: %s\n", *result.MessageId)
+	return nil
+}